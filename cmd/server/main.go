@@ -0,0 +1,63 @@
+package main
+
+import (
+	"log"
+
+	"github.com/Kife22/test/config"
+	"github.com/Kife22/test/controllers"
+	_ "github.com/Kife22/test/docs"
+	"github.com/Kife22/test/middleware"
+	"github.com/Kife22/test/repositories"
+	"github.com/Kife22/test/services"
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// @title Subscription API
+// @version 1.0
+// @description API for managing subscriptions
+// @host localhost:8080
+// @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error loading config: %v", err)
+	}
+
+	db, err := gorm.Open(postgres.Open(cfg.DatabaseURL), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("Error connecting to the database: %v", err)
+	}
+
+	subscriptionRepo := repositories.NewSubscriptionRepository(db)
+	if err := subscriptionRepo.Migrate(); err != nil {
+		log.Fatalf("Error migrating database: %v", err)
+	}
+	userRepo := repositories.NewUserRepository(db)
+	if err := userRepo.Migrate(); err != nil {
+		log.Fatalf("Error migrating database: %v", err)
+	}
+
+	subscriptionService := services.NewSubscriptionService(subscriptionRepo)
+	subscriptionController := controllers.NewSubscriptionController(subscriptionService)
+
+	authService := services.NewAuthService(userRepo, cfg.TokenSecret, cfg.TokenTTL, cfg.SaltRounds)
+	authController := controllers.NewAuthController(authService)
+
+	r := gin.Default()
+
+	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	authController.RegisterRoutes(r.Group("/auth"))
+
+	subscriptions := r.Group("/subscriptions", middleware.RequireAuth(cfg.TokenSecret))
+	subscriptionController.RegisterRoutes(subscriptions)
+
+	r.Run(":" + cfg.ServerPort)
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config holds the server's runtime settings, read from app.env (or the
+// environment, which always takes precedence).
+type Config struct {
+	DatabaseURL string
+	ServerPort  string
+	TokenSecret string
+	TokenTTL    time.Duration
+	SaltRounds  int
+}
+
+// LoadConfig reads app.env from the working directory via Viper, superseding
+// the previous godotenv + os.Getenv usage.
+func LoadConfig() (*Config, error) {
+	viper.SetConfigFile("app.env")
+	viper.SetConfigType("env")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("SERVER_PORT", "8080")
+	viper.SetDefault("TOKEN_TTL", "24h")
+	viper.SetDefault("SALT_ROUNDS", 10)
+
+	if err := viper.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading config: %w", err)
+	}
+
+	tokenTTL, err := time.ParseDuration(viper.GetString("TOKEN_TTL"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOKEN_TTL: %w", err)
+	}
+
+	return &Config{
+		DatabaseURL: viper.GetString("DATABASE_URL"),
+		ServerPort:  viper.GetString("SERVER_PORT"),
+		TokenSecret: viper.GetString("TOKEN_SECRET"),
+		TokenTTL:    tokenTTL,
+		SaltRounds:  viper.GetInt("SALT_ROUNDS"),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"log"
+
+	"github.com/Kife22/test/services"
+	"github.com/gin-gonic/gin"
+)
+
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type UserResponse struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type TokenResponse struct {
+	Token string `json:"token"`
+}
+
+// AuthController exposes registration, login, and logout over JWTs.
+type AuthController struct {
+	service services.AuthService
+}
+
+func NewAuthController(service services.AuthService) *AuthController {
+	return &AuthController{service: service}
+}
+
+func (ctl *AuthController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/register", ctl.Register)
+	rg.POST("/login", ctl.Login)
+	rg.POST("/logout", ctl.Logout)
+}
+
+// @Summary Register a user
+// @Description Create a new user account
+// @Accept json
+// @Produce json
+// @Param credentials body RegisterRequest true "Credentials"
+// @Success 201 {object} UserResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/register [post]
+func (ctl *AuthController) Register(c *gin.Context) {
+	var req RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	user, err := ctl.service.Register(req.Email, req.Password)
+	if err != nil {
+		log.Println("Error registering user:", err)
+		c.JSON(400, ErrorResponse{Message: "could not register user"})
+		return
+	}
+
+	c.JSON(201, UserResponse{ID: user.ID, Email: user.Email})
+}
+
+// @Summary Log in
+// @Description Exchange credentials for a JWT
+// @Accept json
+// @Produce json
+// @Param credentials body LoginRequest true "Credentials"
+// @Success 200 {object} TokenResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /auth/login [post]
+func (ctl *AuthController) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	token, err := ctl.service.Login(req.Email, req.Password)
+	if err != nil {
+		c.JSON(401, ErrorResponse{Message: "invalid email or password"})
+		return
+	}
+
+	c.JSON(200, TokenResponse{Token: token})
+}
+
+// @Summary Log out
+// @Description Invalidate the caller's session. JWTs are stateless and verified per-request, so
+// @Description this is a no-op the client observes by discarding its token.
+// @Success 200 {object} nil
+// @Router /auth/logout [post]
+func (ctl *AuthController) Logout(c *gin.Context) {
+	c.JSON(200, gin.H{"message": "logged out"})
+}
@@ -0,0 +1,377 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Kife22/test/models"
+	"github.com/Kife22/test/services"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 1000
+)
+
+var sortableSubscriptionColumns = map[string]bool{
+	"service_name": true,
+	"price":        true,
+	"start_date":   true,
+	"end_date":     true,
+}
+
+// parseListParams reads the pagination, sorting, and search query params for
+// GET /subscriptions, whitelisting sort_column so it can be interpolated
+// into the ORDER BY clause safely.
+func parseListParams(c *gin.Context) (models.ListParams, error) {
+	limit := defaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			return models.ListParams{}, fmt.Errorf("invalid limit %q", raw)
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if raw := c.Query("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			return models.ListParams{}, fmt.Errorf("invalid offset %q", raw)
+		}
+		offset = parsed
+	}
+
+	if raw := c.Query("page"); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil || page < 1 {
+			return models.ListParams{}, fmt.Errorf("invalid page %q", raw)
+		}
+		pageSize := limit
+		if raw := c.Query("page_size"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed < 0 {
+				return models.ListParams{}, fmt.Errorf("invalid page_size %q", raw)
+			}
+			pageSize = parsed
+		}
+		limit = pageSize
+		offset = (page - 1) * pageSize
+	}
+
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	sortColumn := c.DefaultQuery("sort_column", "service_name")
+	if !sortableSubscriptionColumns[sortColumn] {
+		return models.ListParams{}, fmt.Errorf("sort_column must be one of service_name, price, start_date, end_date")
+	}
+
+	sortOrder := strings.ToLower(c.DefaultQuery("sort_order", "asc"))
+	if sortOrder != "asc" && sortOrder != "desc" {
+		return models.ListParams{}, fmt.Errorf("sort_order must be 'asc' or 'desc'")
+	}
+
+	return models.ListParams{
+		Limit:      limit,
+		Offset:     offset,
+		SortColumn: sortColumn,
+		SortOrder:  sortOrder,
+		Search:     c.Query("search"),
+	}, nil
+}
+
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+type TotalCostResponse struct {
+	TotalCost float64 `json:"total_cost"`
+}
+
+// SubscriptionController adapts HTTP requests to the SubscriptionService,
+// leaving transport concerns (binding, status codes) out of the business
+// logic layer.
+type SubscriptionController struct {
+	service services.SubscriptionService
+}
+
+func NewSubscriptionController(service services.SubscriptionService) *SubscriptionController {
+	return &SubscriptionController{service: service}
+}
+
+func (ctl *SubscriptionController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("", ctl.Create)
+	rg.GET("", ctl.List)
+	rg.GET("/:id", ctl.Get)
+	rg.PUT("/:id", ctl.Update)
+	rg.DELETE("/:id", ctl.Delete)
+	rg.GET("/total", ctl.TotalCost)
+	rg.GET("/report", ctl.Report)
+	rg.POST("/batch", ctl.CreateBatch)
+	rg.PUT("/enable", ctl.Enable)
+	rg.PUT("/disable", ctl.Disable)
+	rg.DELETE("/batch", ctl.DeleteBatch)
+}
+
+type BatchIDsRequest struct {
+	IDs []string `json:"ids" binding:"required"`
+}
+
+// @Summary Create a subscription
+// @Description Create a new subscription for the authenticated user
+// @Accept json
+// @Produce json
+// @Param subscription body models.Subscription true "Subscription"
+// @Success 201 {object} models.Subscription
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions [post]
+func (ctl *SubscriptionController) Create(c *gin.Context) {
+	var subscription models.Subscription
+	if err := c.ShouldBindJSON(&subscription); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if err := ctl.service.Create(c.MustGet("user_id").(string), &subscription); err != nil {
+		log.Println("Error creating subscription:", err)
+		c.JSON(500, ErrorResponse{Message: "Internal server error"})
+		return
+	}
+	log.Printf("Subscription created: %+v\n", subscription)
+	c.JSON(201, subscription)
+}
+
+// @Summary Get all subscriptions
+// @Description Get a paginated, sorted, and optionally searched page of the authenticated user's
+// @Description subscriptions
+// @Produce json
+// @Param limit query int false "Max rows to return (default 50, max 1000)"
+// @Param offset query int false "Rows to skip"
+// @Param page query int false "1-indexed page number, alternative to offset"
+// @Param page_size query int false "Rows per page, used with page"
+// @Param sort_column query string false "service_name (default), price, start_date, or end_date"
+// @Param sort_order query string false "asc (default) or desc"
+// @Param search query string false "Fuzzy match against service_name"
+// @Success 200 {object} models.SubscriptionPage
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions [get]
+func (ctl *SubscriptionController) List(c *gin.Context) {
+	params, err := parseListParams(c)
+	if err != nil {
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	page, err := ctl.service.List(c.MustGet("user_id").(string), params)
+	if err != nil {
+		c.JSON(500, ErrorResponse{Message: "Internal server error"})
+		return
+	}
+	c.JSON(200, page)
+}
+
+// @Summary Get a subscription by ID
+// @Description Get a subscription by its ID, if it belongs to the authenticated user
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} models.Subscription
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/{id} [get]
+func (ctl *SubscriptionController) Get(c *gin.Context) {
+	subscription, err := ctl.service.Get(c.Param("id"), c.MustGet("user_id").(string))
+	if err != nil {
+		log.Println("Subscription not found:", err)
+		c.JSON(404, ErrorResponse{Message: "Subscription not found!"})
+		return
+	}
+	c.JSON(200, subscription)
+}
+
+// @Summary Update a subscription by ID
+// @Description Update an existing subscription by its ID, if it belongs to the authenticated user
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param subscription body models.Subscription true "Updated Subscription"
+// @Success 200 {object} models.Subscription
+// @Failure 404 {object} ErrorResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/{id} [put]
+func (ctl *SubscriptionController) Update(c *gin.Context) {
+	id := c.Param("id")
+	var subscription models.Subscription
+	if err := c.ShouldBindJSON(&subscription); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	updated, err := ctl.service.Update(id, c.MustGet("user_id").(string), &subscription)
+	if err != nil {
+		log.Println("Subscription not found:", err)
+		c.JSON(404, ErrorResponse{Message: "Subscription not found!"})
+		return
+	}
+	log.Printf("Subscription updated: %+v\n", updated)
+	c.JSON(200, updated)
+}
+
+// @Summary Delete a subscription by ID
+// @Description Delete a subscription by its ID, if it belongs to the authenticated user
+// @Param id path string true "Subscription ID"
+// @Success 204 {object} nil
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/{id} [delete]
+func (ctl *SubscriptionController) Delete(c *gin.Context) {
+	id := c.Param("id")
+	if err := ctl.service.Delete(id, c.MustGet("user_id").(string)); err != nil {
+		log.Println("Subscription not found:", err)
+		c.JSON(404, ErrorResponse{Message: "Subscription not found!"})
+		return
+	}
+	log.Printf("Subscription deleted: %s\n", id)
+	c.JSON(204, nil)
+}
+
+// @Summary Get total cost of subscriptions
+// @Description Get the prorated total cost of the authenticated user's subscriptions, optionally
+// @Description scoped by service and a "from"/"to" (YYYY-MM) date range
+// @Produce json
+// @Param service_name query string false "Service Name"
+// @Param from query string false "Start of the range, YYYY-MM"
+// @Param to query string false "End of the range, YYYY-MM"
+// @Success 200 {object} TotalCostResponse "e.g. {\"total_cost\": 2997}"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/total [get]
+func (ctl *SubscriptionController) TotalCost(c *gin.Context) {
+	userID := c.MustGet("user_id").(string)
+	total, err := ctl.service.TotalCost(userID, c.Query("service_name"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+	c.JSON(200, TotalCostResponse{TotalCost: total})
+}
+
+// @Summary Get a subscription spend report
+// @Description Get the authenticated user's prorated spend over a "from"/"to" (YYYY-MM) range,
+// @Description grouped by service or by month
+// @Produce json
+// @Param group_by query string false "'service' (default) or 'month'"
+// @Param service_name query string false "Service Name"
+// @Param from query string false "Start of the range, YYYY-MM"
+// @Param to query string false "End of the range, YYYY-MM"
+// @Success 200 {array} models.ReportEntry "e.g. [{\"key\": \"2026-01\", \"total\": 999}]"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/report [get]
+func (ctl *SubscriptionController) Report(c *gin.Context) {
+	userID := c.MustGet("user_id").(string)
+	groupBy := c.DefaultQuery("group_by", "service")
+	entries, err := ctl.service.Report(groupBy, userID, c.Query("service_name"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+	c.JSON(200, entries)
+}
+
+// @Summary Create subscriptions in bulk
+// @Description Create several subscriptions for the authenticated user in one transaction; if any
+// @Description one fails, the whole batch rolls back
+// @Accept json
+// @Produce json
+// @Param subscriptions body []models.Subscription true "Subscriptions"
+// @Success 201 {array} models.BatchResult
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/batch [post]
+func (ctl *SubscriptionController) CreateBatch(c *gin.Context) {
+	var subscriptions []*models.Subscription
+	if err := c.ShouldBindJSON(&subscriptions); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	results := ctl.service.CreateBatch(c.MustGet("user_id").(string), subscriptions)
+	c.JSON(201, results)
+}
+
+// @Summary Enable subscriptions in bulk
+// @Description Mark the given subscription IDs active, in one transaction
+// @Accept json
+// @Produce json
+// @Param ids body BatchIDsRequest true "Subscription IDs"
+// @Success 200 {array} models.BatchResult
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/enable [put]
+func (ctl *SubscriptionController) Enable(c *gin.Context) {
+	var req BatchIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	results := ctl.service.Enable(c.MustGet("user_id").(string), req.IDs)
+	c.JSON(200, results)
+}
+
+// @Summary Disable subscriptions in bulk
+// @Description Mark the given subscription IDs inactive, in one transaction
+// @Accept json
+// @Produce json
+// @Param ids body BatchIDsRequest true "Subscription IDs"
+// @Success 200 {array} models.BatchResult
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/disable [put]
+func (ctl *SubscriptionController) Disable(c *gin.Context) {
+	var req BatchIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	results := ctl.service.Disable(c.MustGet("user_id").(string), req.IDs)
+	c.JSON(200, results)
+}
+
+// @Summary Delete subscriptions in bulk
+// @Description Delete the given subscription IDs in one transaction; if any one fails, the whole
+// @Description batch rolls back
+// @Accept json
+// @Produce json
+// @Param ids body BatchIDsRequest true "Subscription IDs"
+// @Success 200 {array} models.BatchResult
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /subscriptions/batch [delete]
+func (ctl *SubscriptionController) DeleteBatch(c *gin.Context) {
+	var req BatchIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println("Error binding JSON:", err)
+		c.JSON(400, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	results := ctl.service.DeleteBatch(c.MustGet("user_id").(string), req.IDs)
+	c.JSON(200, results)
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RequireAuth validates the bearer JWT on every request and puts the
+// authenticated user's ID into the Gin context as "user_id", so handlers
+// never trust a client-supplied user ID.
+func RequireAuth(jwtSecret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		tokenString, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || tokenString == "" {
+			c.AbortWithStatusJSON(401, gin.H{"message": "missing or invalid Authorization header"})
+			return
+		}
+
+		claims := &jwt.RegisteredClaims{}
+		token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return []byte(jwtSecret), nil
+		})
+		if err != nil || !token.Valid {
+			c.AbortWithStatusJSON(401, gin.H{"message": "invalid or expired token"})
+			return
+		}
+
+		c.Set("user_id", claims.Subject)
+		c.Next()
+	}
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const MonthYearLayout = "01-2006"
+
+// MonthYear is a calendar month exchanged over the API as "MM-YYYY", the
+// format used throughout the subscription tracking domain, and persisted as
+// a native timestamp column.
+type MonthYear struct {
+	time.Time
+}
+
+func (my MonthYear) MarshalJSON() ([]byte, error) {
+	if my.Time.IsZero() {
+		return []byte(`""`), nil
+	}
+	return []byte(fmt.Sprintf("%q", my.Time.Format(MonthYearLayout))), nil
+}
+
+func (my *MonthYear) UnmarshalJSON(data []byte) error {
+	s := string(bytes.Trim(data, `"`))
+	if s == "" || s == "null" {
+		my.Time = time.Time{}
+		return nil
+	}
+	t, err := time.Parse(MonthYearLayout, s)
+	if err != nil {
+		return fmt.Errorf("invalid date %q, expected MM-YYYY: %w", s, err)
+	}
+	my.Time = t
+	return nil
+}
+
+// Scan and Value implement sql.Scanner/driver.Valuer so GORM can read and
+// write MonthYear as a plain timestamp column.
+func (my *MonthYear) Scan(value interface{}) error {
+	if value == nil {
+		my.Time = time.Time{}
+		return nil
+	}
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into MonthYear", value)
+	}
+	my.Time = t
+	return nil
+}
+
+func (my MonthYear) Value() (driver.Value, error) {
+	if my.Time.IsZero() {
+		return nil, nil
+	}
+	return my.Time, nil
+}
+
+type Subscription struct {
+	ID          string    `gorm:"primaryKey" json:"id"`
+	ServiceName string    `json:"service_name" binding:"required"`
+	Price       int       `json:"price" binding:"required"`
+	UserID      string    `json:"user_id" binding:"required"`
+	StartDate   MonthYear `json:"start_date" binding:"required"`
+	EndDate     MonthYear `json:"end_date" binding:"omitempty"`
+	Active      bool      `json:"active" gorm:"default:true"`
+}
+
+// BeforeCreate assigns a server-generated ID, since clients no longer choose
+// their own and nothing else enforces uniqueness, and activates the new
+// subscription (use PUT /subscriptions/disable to turn it off afterwards).
+func (s *Subscription) BeforeCreate(tx *gorm.DB) error {
+	s.ID = uuid.NewString()
+	s.Active = true
+	return nil
+}
+
+type ReportEntry struct {
+	Key   string  `json:"key"`
+	Total float64 `json:"total"`
+}
+
+// ListParams carries the pagination, sorting, and search options for a
+// subscriptions listing.
+type ListParams struct {
+	Limit      int
+	Offset     int
+	SortColumn string
+	SortOrder  string
+	Search     string
+}
+
+// SubscriptionPage is the paginated wrapper returned by GET /subscriptions.
+type SubscriptionPage struct {
+	Data  []Subscription `json:"data"`
+	Total int64          `json:"total"`
+	Page  int            `json:"page"`
+}
+
+// BatchResult reports the outcome of one item in a batch create/enable/
+// disable/delete operation.
+type BatchResult struct {
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	ID           string `gorm:"primaryKey" json:"id"`
+	Email        string `gorm:"uniqueIndex" json:"email" binding:"required,email"`
+	PasswordHash string `json:"-"`
+}
+
+// BeforeCreate assigns a server-generated ID, since registering users never
+// supply their own.
+func (u *User) BeforeCreate(tx *gorm.DB) error {
+	if u.ID == "" {
+		u.ID = uuid.NewString()
+	}
+	return nil
+}
+
+func (u *User) SetPassword(password string, cost int) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = string(hash)
+	return nil
+}
+
+func (u *User) CheckPassword(password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
+}
@@ -0,0 +1,217 @@
+package repositories
+
+import (
+	"strings"
+
+	"github.com/Kife22/test/models"
+	"gorm.io/gorm"
+)
+
+// SubscriptionRepository is the persistence boundary for subscriptions, so
+// services can be unit tested against a mock instead of a live database.
+type SubscriptionRepository interface {
+	Migrate() error
+	Create(subscription *models.Subscription) error
+	FindPage(userID string, params models.ListParams) ([]models.Subscription, int64, error)
+	FindFiltered(userID, serviceName string) ([]models.Subscription, error)
+	FindByID(id, userID string) (*models.Subscription, error)
+	Update(subscription *models.Subscription) error
+	Delete(id, userID string) error
+	CreateBatch(subscriptions []*models.Subscription) []models.BatchResult
+	SetActive(ids []string, userID string, active bool) []models.BatchResult
+	DeleteBatch(ids []string, userID string) []models.BatchResult
+}
+
+type subscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewSubscriptionRepository(db *gorm.DB) SubscriptionRepository {
+	return &subscriptionRepository{db: db}
+}
+
+// Migrate brings the subscriptions table up to date, including upgrading
+// start_date/end_date from the old "MM-YYYY" text columns to timestamps for
+// databases created before Subscription's date fields became time.Time.
+func (r *subscriptionRepository) Migrate() error {
+	if err := r.migrateLegacyDateColumns(); err != nil {
+		return err
+	}
+	return r.db.AutoMigrate(&models.Subscription{})
+}
+
+func (r *subscriptionRepository) migrateLegacyDateColumns() error {
+	var dataType string
+	err := r.db.Raw(`
+		SELECT data_type FROM information_schema.columns
+		WHERE table_name = 'subscriptions' AND column_name = 'start_date'
+	`).Scan(&dataType).Error
+	if err != nil {
+		return err
+	}
+	if dataType != "text" && dataType != "character varying" {
+		return nil
+	}
+
+	return r.db.Exec(`
+		ALTER TABLE subscriptions
+			ALTER COLUMN start_date TYPE timestamptz USING to_timestamp(start_date, 'MM-YYYY'),
+			ALTER COLUMN end_date TYPE timestamptz USING (
+				CASE WHEN end_date = '' THEN NULL ELSE to_timestamp(end_date, 'MM-YYYY') END
+			)
+	`).Error
+}
+
+func (r *subscriptionRepository) Create(subscription *models.Subscription) error {
+	return r.db.Create(subscription).Error
+}
+
+// FindPage returns a page of the user's subscriptions, optionally matching
+// Search against service_name, sorted by SortColumn/SortOrder. The caller is
+// responsible for whitelisting SortColumn against SQL injection.
+func (r *subscriptionRepository) FindPage(userID string, params models.ListParams) ([]models.Subscription, int64, error) {
+	query := r.db.Model(&models.Subscription{}).Where("user_id = ?", userID)
+	if params.Search != "" {
+		query = query.Where("service_name ILIKE ?", "%"+params.Search+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := params.SortColumn + " " + strings.ToUpper(params.SortOrder)
+	query = query.Order(order).Limit(params.Limit).Offset(params.Offset)
+
+	var subscriptions []models.Subscription
+	err := query.Find(&subscriptions).Error
+	return subscriptions, total, err
+}
+
+func (r *subscriptionRepository) FindFiltered(userID, serviceName string) ([]models.Subscription, error) {
+	query := r.db.Model(&models.Subscription{}).Where("user_id = ?", userID)
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+
+	var subscriptions []models.Subscription
+	err := query.Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+func (r *subscriptionRepository) FindByID(id, userID string) (*models.Subscription, error) {
+	var subscription models.Subscription
+	if err := r.db.First(&subscription, "id = ? AND user_id = ?", id, userID).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r *subscriptionRepository) Update(subscription *models.Subscription) error {
+	return r.db.Save(subscription).Error
+}
+
+func (r *subscriptionRepository) Delete(id, userID string) error {
+	result := r.db.Delete(&models.Subscription{}, "id = ? AND user_id = ?", id, userID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CreateBatch creates every subscription in a single transaction: if any one
+// fails, the whole batch rolls back and every item - including ones already
+// reported as created - is reported as "rolled back" except the one that
+// caused the failure, which keeps its real error.
+func (r *subscriptionRepository) CreateBatch(subscriptions []*models.Subscription) []models.BatchResult {
+	results := make([]models.BatchResult, 0, len(subscriptions))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, subscription := range subscriptions {
+			if err := tx.Create(subscription).Error; err != nil {
+				results = append(results, models.BatchResult{Status: "failed", Error: err.Error()})
+				return err
+			}
+			results = append(results, models.BatchResult{ID: subscription.ID, Status: "created"})
+		}
+		return nil
+	})
+	return fillRolledBack(results, subscriptionIDs(subscriptions), err)
+}
+
+// SetActive toggles Active on every ID in a single transaction: if any ID
+// doesn't belong to the user, the whole batch rolls back.
+func (r *subscriptionRepository) SetActive(ids []string, userID string, active bool) []models.BatchResult {
+	results := make([]models.BatchResult, 0, len(ids))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			result := tx.Model(&models.Subscription{}).Where("id = ? AND user_id = ?", id, userID).Update("active", active)
+			if result.Error != nil {
+				results = append(results, models.BatchResult{ID: id, Status: "failed", Error: result.Error.Error()})
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				err := gorm.ErrRecordNotFound
+				results = append(results, models.BatchResult{ID: id, Status: "failed", Error: err.Error()})
+				return err
+			}
+			results = append(results, models.BatchResult{ID: id, Status: "ok"})
+		}
+		return nil
+	})
+	return fillRolledBack(results, ids, err)
+}
+
+// DeleteBatch deletes every ID in a single transaction: if any ID doesn't
+// belong to the user, the whole batch rolls back.
+func (r *subscriptionRepository) DeleteBatch(ids []string, userID string) []models.BatchResult {
+	results := make([]models.BatchResult, 0, len(ids))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			result := tx.Delete(&models.Subscription{}, "id = ? AND user_id = ?", id, userID)
+			if result.Error != nil {
+				results = append(results, models.BatchResult{ID: id, Status: "failed", Error: result.Error.Error()})
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				err := gorm.ErrRecordNotFound
+				results = append(results, models.BatchResult{ID: id, Status: "failed", Error: err.Error()})
+				return err
+			}
+			results = append(results, models.BatchResult{ID: id, Status: "deleted"})
+		}
+		return nil
+	})
+	return fillRolledBack(results, ids, err)
+}
+
+// fillRolledBack reconciles a per-item result array with the fact that the
+// whole transaction reverted: the offending item keeps its real error, every
+// item before it (reported as succeeding before the rollback happened) is
+// rewritten to "rolled back", and every item after it (never reached) is
+// appended the same way.
+func fillRolledBack(results []models.BatchResult, ids []string, err error) []models.BatchResult {
+	if err == nil {
+		return results
+	}
+	for i := range results {
+		if i == len(results)-1 {
+			break
+		}
+		results[i] = models.BatchResult{ID: results[i].ID, Status: "rolled back"}
+	}
+	for i := len(results); i < len(ids); i++ {
+		results = append(results, models.BatchResult{ID: ids[i], Status: "rolled back"})
+	}
+	return results
+}
+
+func subscriptionIDs(subscriptions []*models.Subscription) []string {
+	ids := make([]string, len(subscriptions))
+	for i, subscription := range subscriptions {
+		ids[i] = subscription.ID
+	}
+	return ids
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Kife22/test/models"
+)
+
+func TestFillRolledBackNoError(t *testing.T) {
+	results := []models.BatchResult{{ID: "a", Status: "created"}}
+	got := fillRolledBack(results, []string{"a"}, nil)
+	if len(got) != 1 || got[0].Status != "created" {
+		t.Fatalf("expected untouched results on success, got %+v", got)
+	}
+}
+
+func TestFillRolledBackRewritesPrecedingSuccesses(t *testing.T) {
+	// "a" and "b" were already reported as succeeding before "c" failed and
+	// the whole transaction rolled back, so only "c" keeps its real error;
+	// "a" and "b" must no longer say they succeeded.
+	results := []models.BatchResult{
+		{ID: "a", Status: "created"},
+		{ID: "b", Status: "created"},
+		{ID: "c", Status: "failed", Error: "duplicate key"},
+	}
+	ids := []string{"a", "b", "c", "d"}
+
+	got := fillRolledBack(results, ids, errors.New("duplicate key"))
+
+	if len(got) != len(ids) {
+		t.Fatalf("expected %d results, got %d", len(ids), len(got))
+	}
+	if got[0].Status != "rolled back" || got[1].Status != "rolled back" {
+		t.Fatalf("expected preceding items rewritten to rolled back, got %+v", got[:2])
+	}
+	if got[2].Status != "failed" || got[2].Error != "duplicate key" {
+		t.Fatalf("expected offending item to keep its real error, got %+v", got[2])
+	}
+	if got[3].Status != "rolled back" {
+		t.Fatalf("expected never-reached item appended as rolled back, got %+v", got[3])
+	}
+}
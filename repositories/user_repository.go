@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"github.com/Kife22/test/models"
+	"gorm.io/gorm"
+)
+
+type UserRepository interface {
+	Migrate() error
+	Create(user *models.User) error
+	FindByEmail(email string) (*models.User, error)
+	FindByID(id string) (*models.User, error)
+}
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+func (r *userRepository) Migrate() error {
+	return r.db.AutoMigrate(&models.User{})
+}
+
+func (r *userRepository) Create(user *models.User) error {
+	return r.db.Create(user).Error
+}
+
+func (r *userRepository) FindByEmail(email string) (*models.User, error) {
+	var user models.User
+	if err := r.db.First(&user, "email = ?", email).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindByID(id string) (*models.User, error) {
+	var user models.User
+	if err := r.db.First(&user, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Kife22/test/models"
+	"github.com/Kife22/test/repositories"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AuthService registers and authenticates users, issuing JWTs on login.
+type AuthService interface {
+	Register(email, password string) (*models.User, error)
+	Login(email, password string) (string, error)
+}
+
+type authService struct {
+	repo       repositories.UserRepository
+	jwtSecret  string
+	tokenTTL   time.Duration
+	saltRounds int
+}
+
+func NewAuthService(repo repositories.UserRepository, jwtSecret string, tokenTTL time.Duration, saltRounds int) AuthService {
+	return &authService{repo: repo, jwtSecret: jwtSecret, tokenTTL: tokenTTL, saltRounds: saltRounds}
+}
+
+func (s *authService) Register(email, password string) (*models.User, error) {
+	user := &models.User{Email: email}
+	if err := user.SetPassword(password, s.saltRounds); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *authService) Login(email, password string) (string, error) {
+	user, err := s.repo.FindByEmail(email)
+	if err != nil {
+		return "", fmt.Errorf("invalid email or password")
+	}
+	if err := user.CheckPassword(password); err != nil {
+		return "", fmt.Errorf("invalid email or password")
+	}
+
+	claims := jwt.RegisteredClaims{
+		Subject:   user.ID,
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.tokenTTL)),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.jwtSecret))
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Kife22/test/models"
+	"github.com/Kife22/test/repositories"
+)
+
+const ReportDateLayout = "2006-01"
+
+// SubscriptionService holds the business logic around subscriptions -
+// proration, reporting - independent of how they're stored or exposed.
+type SubscriptionService interface {
+	Create(userID string, subscription *models.Subscription) error
+	List(userID string, params models.ListParams) (*models.SubscriptionPage, error)
+	Get(id, userID string) (*models.Subscription, error)
+	Update(id, userID string, subscription *models.Subscription) (*models.Subscription, error)
+	Delete(id, userID string) error
+	TotalCost(userID, serviceName, from, to string) (float64, error)
+	Report(groupBy, userID, serviceName, from, to string) ([]models.ReportEntry, error)
+	CreateBatch(userID string, subscriptions []*models.Subscription) []models.BatchResult
+	Enable(userID string, ids []string) []models.BatchResult
+	Disable(userID string, ids []string) []models.BatchResult
+	DeleteBatch(userID string, ids []string) []models.BatchResult
+}
+
+type subscriptionService struct {
+	repo repositories.SubscriptionRepository
+}
+
+func NewSubscriptionService(repo repositories.SubscriptionRepository) SubscriptionService {
+	return &subscriptionService{repo: repo}
+}
+
+func (s *subscriptionService) Create(userID string, subscription *models.Subscription) error {
+	subscription.UserID = userID
+	return s.repo.Create(subscription)
+}
+
+func (s *subscriptionService) List(userID string, params models.ListParams) (*models.SubscriptionPage, error) {
+	subscriptions, total, err := s.repo.FindPage(userID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	if params.Limit > 0 {
+		page = params.Offset/params.Limit + 1
+	}
+	return &models.SubscriptionPage{Data: subscriptions, Total: total, Page: page}, nil
+}
+
+func (s *subscriptionService) Get(id, userID string) (*models.Subscription, error) {
+	return s.repo.FindByID(id, userID)
+}
+
+// Update overlays the caller-supplied fields onto the existing row rather
+// than saving the request body as-is, since Active is server-managed (only
+// /subscriptions/enable and /subscriptions/disable are allowed to change it)
+// and must survive an unrelated PUT.
+func (s *subscriptionService) Update(id, userID string, subscription *models.Subscription) (*models.Subscription, error) {
+	existing, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	subscription.ID = existing.ID
+	subscription.UserID = userID
+	subscription.Active = existing.Active
+	if err := s.repo.Update(subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *subscriptionService) Delete(id, userID string) error {
+	return s.repo.Delete(id, userID)
+}
+
+func (s *subscriptionService) CreateBatch(userID string, subscriptions []*models.Subscription) []models.BatchResult {
+	for _, subscription := range subscriptions {
+		subscription.UserID = userID
+	}
+	return s.repo.CreateBatch(subscriptions)
+}
+
+func (s *subscriptionService) Enable(userID string, ids []string) []models.BatchResult {
+	return s.repo.SetActive(ids, userID, true)
+}
+
+func (s *subscriptionService) Disable(userID string, ids []string) []models.BatchResult {
+	return s.repo.SetActive(ids, userID, false)
+}
+
+func (s *subscriptionService) DeleteBatch(userID string, ids []string) []models.BatchResult {
+	return s.repo.DeleteBatch(ids, userID)
+}
+
+func (s *subscriptionService) TotalCost(userID, serviceName, from, to string) (float64, error) {
+	fromTime, toTime, err := parseReportRange(from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions, err := s.repo.FindFiltered(userID, serviceName)
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+	for _, sub := range subscriptions {
+		total += float64(sub.Price * monthsActiveInRange(sub, fromTime, toTime))
+	}
+	return total, nil
+}
+
+func (s *subscriptionService) Report(groupBy, userID, serviceName, from, to string) ([]models.ReportEntry, error) {
+	if groupBy != "service" && groupBy != "month" {
+		return nil, fmt.Errorf("group_by must be 'service' or 'month'")
+	}
+
+	fromTime, toTime, err := parseReportRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.repo.FindFiltered(userID, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]float64{}
+	for _, sub := range subscriptions {
+		if groupBy == "service" {
+			totals[sub.ServiceName] += float64(sub.Price * monthsActiveInRange(sub, fromTime, toTime))
+			continue
+		}
+
+		start := sub.StartDate.Time
+		if start.Before(fromTime) {
+			start = fromTime
+		}
+		end := sub.EndDate.Time
+		if end.IsZero() || end.After(toTime) {
+			end = toTime
+		}
+		for month := start; !month.After(end); month = month.AddDate(0, 1, 0) {
+			totals[month.Format(ReportDateLayout)] += float64(sub.Price)
+		}
+	}
+
+	entries := make([]models.ReportEntry, 0, len(totals))
+	for key, total := range totals {
+		entries = append(entries, models.ReportEntry{Key: key, Total: total})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries, nil
+}
+
+// parseReportRange parses the optional "from"/"to" (YYYY-MM) query params
+// shared by TotalCost and Report. A missing "from" defaults to 1970-01 and a
+// missing "to" to the current time.
+func parseReportRange(from, to string) (time.Time, time.Time, error) {
+	fromTime := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	toTime := time.Now()
+
+	if from != "" {
+		parsed, err := time.Parse(ReportDateLayout, from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from date %q, expected YYYY-MM", from)
+		}
+		fromTime = parsed
+	}
+	if to != "" {
+		parsed, err := time.Parse(ReportDateLayout, to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to date %q, expected YYYY-MM", to)
+		}
+		toTime = parsed
+	}
+	return fromTime, toTime, nil
+}
+
+// monthsActiveInRange counts how many calendar months sub was active within
+// [from, to], clamping to the range on both ends. A subscription with no
+// EndDate is treated as still active at to.
+func monthsActiveInRange(sub models.Subscription, from, to time.Time) int {
+	start := sub.StartDate.Time
+	end := sub.EndDate.Time
+	if end.IsZero() || end.After(to) {
+		end = to
+	}
+	if start.Before(from) {
+		start = from
+	}
+	if start.After(end) {
+		return 0
+	}
+	return (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month()) + 1
+}
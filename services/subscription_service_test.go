@@ -0,0 +1,127 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kife22/test/models"
+)
+
+// mockSubscriptionRepository is an in-memory stand-in for
+// repositories.SubscriptionRepository, letting the service tests run
+// without a database.
+type mockSubscriptionRepository struct {
+	subscriptions map[string]*models.Subscription
+}
+
+func newMockSubscriptionRepository() *mockSubscriptionRepository {
+	return &mockSubscriptionRepository{subscriptions: map[string]*models.Subscription{}}
+}
+
+func (m *mockSubscriptionRepository) Migrate() error { return nil }
+
+func (m *mockSubscriptionRepository) Create(subscription *models.Subscription) error {
+	m.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (m *mockSubscriptionRepository) FindPage(userID string, params models.ListParams) ([]models.Subscription, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *mockSubscriptionRepository) FindFiltered(userID, serviceName string) ([]models.Subscription, error) {
+	var subscriptions []models.Subscription
+	for _, subscription := range m.subscriptions {
+		if subscription.UserID != userID {
+			continue
+		}
+		if serviceName != "" && subscription.ServiceName != serviceName {
+			continue
+		}
+		subscriptions = append(subscriptions, *subscription)
+	}
+	return subscriptions, nil
+}
+
+func (m *mockSubscriptionRepository) FindByID(id, userID string) (*models.Subscription, error) {
+	subscription, ok := m.subscriptions[id]
+	if !ok || subscription.UserID != userID {
+		return nil, gormRecordNotFound{}
+	}
+	clone := *subscription
+	return &clone, nil
+}
+
+func (m *mockSubscriptionRepository) Update(subscription *models.Subscription) error {
+	m.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (m *mockSubscriptionRepository) Delete(id, userID string) error { return nil }
+
+func (m *mockSubscriptionRepository) CreateBatch(subscriptions []*models.Subscription) []models.BatchResult {
+	return nil
+}
+
+func (m *mockSubscriptionRepository) SetActive(ids []string, userID string, active bool) []models.BatchResult {
+	return nil
+}
+
+func (m *mockSubscriptionRepository) DeleteBatch(ids []string, userID string) []models.BatchResult {
+	return nil
+}
+
+type gormRecordNotFound struct{}
+
+func (gormRecordNotFound) Error() string { return "record not found" }
+
+func TestUpdatePreservesActiveAcrossPlainFieldEdit(t *testing.T) {
+	repo := newMockSubscriptionRepository()
+	repo.subscriptions["sub-1"] = &models.Subscription{
+		ID: "sub-1", UserID: "user-1", ServiceName: "Netflix", Price: 999, Active: true,
+	}
+	svc := NewSubscriptionService(repo)
+
+	// A PUT payload that only changes the price and doesn't mention "active"
+	// at all, as a normal client would send.
+	edit := &models.Subscription{ServiceName: "Netflix", Price: 1299}
+
+	updated, err := svc.Update("sub-1", "user-1", edit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !updated.Active {
+		t.Fatalf("expected active subscription to remain active after an unrelated field edit")
+	}
+	if updated.Price != 1299 {
+		t.Fatalf("expected price to be updated, got %d", updated.Price)
+	}
+}
+
+func TestMonthsActiveInRangeClampsToWindow(t *testing.T) {
+	fromTime, toTime, err := parseReportRange("2026-01", "2026-06")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sub := models.Subscription{
+		StartDate: models.MonthYear{Time: mustParseMonthYear(t, "03-2025")},
+	}
+	if got := monthsActiveInRange(sub, fromTime, toTime); got != 6 {
+		t.Fatalf("expected a subscription active before and through the whole window to count 6 months, got %d", got)
+	}
+
+	sub.EndDate = models.MonthYear{Time: mustParseMonthYear(t, "03-2026")}
+	if got := monthsActiveInRange(sub, fromTime, toTime); got != 3 {
+		t.Fatalf("expected a subscription ending mid-window to count 3 months, got %d", got)
+	}
+}
+
+func mustParseMonthYear(t *testing.T, s string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(models.MonthYearLayout, s)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %q: %v", s, err)
+	}
+	return parsed
+}